@@ -0,0 +1,145 @@
+package movers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+const (
+	alpacaBaseURL = `https://data.alpaca.markets/v1beta1/screener/stocks`
+
+	envAlpacaKeyID     = `APCA_API_KEY_ID`
+	envAlpacaSecretKey = `APCA_API_SECRET_KEY`
+)
+
+// alpacaGetter is a Getter backed by Alpaca's market-data screener endpoints.
+// Unlike the wsj source it requires no historical Date and is suitable for
+// pulling today's movers intraday.
+type alpacaGetter struct {
+	keyID     string
+	secretKey string
+	client    *http.Client
+}
+
+// NewAlpacaGetter returns a Getter that fetches gainers/losers from Alpaca's
+// screener API. Credentials are read from the APCA_API_KEY_ID and
+// APCA_API_SECRET_KEY environment variables.
+func NewAlpacaGetter() Getter {
+	return &alpacaGetter{
+		keyID:     os.Getenv(envAlpacaKeyID),
+		secretKey: os.Getenv(envAlpacaSecretKey),
+		client:    &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+type alpacaMover struct {
+	Symbol        string  `json:"symbol"`
+	Price         float64 `json:"price"`
+	ChangePercent float64 `json:"percent_change"`
+	Volume        int     `json:"volume"`
+}
+
+type alpacaScreenerResponse struct {
+	Gainers []alpacaMover `json:"gainers"`
+	Losers  []alpacaMover `json:"losers"`
+}
+
+// Get implements Getter. Alpaca's screener only reflects the current
+// session, so it cannot serve an arbitrary historical d; d is still run
+// through Validate so a garbage or out-of-range date is rejected rather
+// than silently answered with today's data.
+func (a *alpacaGetter) Get(list MoverList, d Date) (result []Stock, err error) {
+	if err = d.Validate(); err != nil {
+		return nil, err
+	}
+	return a.GetLive(list)
+}
+
+// GetLive implements LiveGetter, returning the current session's movers.
+func (a *alpacaGetter) GetLive(list MoverList) (result []Stock, err error) {
+	req, err := http.NewRequest(http.MethodGet, alpacaBaseURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(`APCA-API-KEY-ID`, a.keyID)
+	req.Header.Set(`APCA-API-SECRET-KEY`, a.secretKey)
+	res, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(`alpaca: status code error: %d %s`, res.StatusCode, res.Status)
+	}
+	var parsed alpacaScreenerResponse
+	if err = json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return nil, err
+	}
+	movers := parsed.Gainers
+	if list == USCompositeLosers {
+		movers = parsed.Losers
+	}
+	result = make([]Stock, len(movers))
+	for i, m := range movers {
+		result[i] = Stock{
+			Ticker:    m.Symbol,
+			Price:     m.Price,
+			PctChange: m.ChangePercent,
+			Volume:    m.Volume,
+		}
+	}
+	return result, nil
+}
+
+const screenerPollInterval = 5 * time.Second
+
+// Stream implements StreamSource by polling the screener endpoint on an
+// interval and emitting a StreamUpdate for each entry whose percent change
+// has moved since the last poll. It stands in for a true trade/quote stream
+// client until one is wired up; the polling cadence and reconnect semantics
+// are isolated here so that can be swapped in without touching the hub.
+func (a *alpacaGetter) Stream(ctx context.Context) (<-chan StreamUpdate, error) {
+	out := make(chan StreamUpdate)
+	go func() {
+		defer close(out)
+		last := make(map[string]float64)
+		ticker := time.NewTicker(screenerPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			for _, list := range []MoverList{USCompositeGainers, USCompositeLosers} {
+				stocks, err := a.GetLive(list)
+				if err != nil {
+					continue
+				}
+				for _, s := range stocks {
+					key := string(list) + `:` + s.Ticker
+					if prev, ok := last[key]; ok && prev == s.PctChange {
+						continue
+					}
+					last[key] = s.PctChange
+					select {
+					case out <- StreamUpdate{List: list, Stock: s}:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+func init() {
+	RegisterSource(`alpaca`, func() Getter {
+		return NewAlpacaGetter()
+	})
+}
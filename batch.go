@@ -0,0 +1,102 @@
+package movers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const defaultRangeConcurrency = 4
+
+// dayResult is one record in a /gainers or /losers range response: either a
+// day's stocks, or the error encountered fetching that day.
+type dayResult struct {
+	Date   string  `json:"date"`
+	Stocks []Stock `json:"stocks,omitempty"`
+	Error  string  `json:"error,omitempty"`
+}
+
+func dateString(d Date) string {
+	return fmt.Sprintf(`%04d-%02d-%02d`, d.Year, int(d.Month), d.Day)
+}
+
+// weekdaysBetween returns every weekday Date from start to end, inclusive.
+func weekdaysBetween(start, end time.Time) (dates []Date) {
+	for t := start; !t.After(end); t = t.AddDate(0, 0, 1) {
+		switch t.Weekday() {
+		case time.Saturday, time.Sunday:
+			continue
+		}
+		dates = append(dates, Date{Year: t.Year(), Month: t.Month(), Day: t.Day()})
+	}
+	return
+}
+
+// handleRange returns a handler backing GET /gainers and /losers with
+// ?from=YYYY-MM-DD&to=YYYY-MM-DD set: it fetches every weekday in the range
+// from a bounded worker pool and streams the results as NDJSON {date,
+// stocks} records in completion order, so a single bad day is reported
+// inline instead of aborting the rest of the range.
+func (s *server) handleRange(list MoverList) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		from, err := time.Parse(`2006-01-02`, r.URL.Query().Get(`from`))
+		if err != nil {
+			http.Error(w, `invalid from date: `+err.Error(), http.StatusBadRequest)
+			return
+		}
+		to, err := time.Parse(`2006-01-02`, r.URL.Query().Get(`to`))
+		if err != nil {
+			http.Error(w, `invalid to date: `+err.Error(), http.StatusBadRequest)
+			return
+		}
+		concurrency := defaultRangeConcurrency
+		if c, err := strconv.Atoi(r.URL.Query().Get(`concurrency`)); err == nil && c > 0 {
+			concurrency = c
+		}
+		g, err := s.sourceFor(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		dates := weekdaysBetween(from, to)
+		results := make(chan dayResult, len(dates))
+		grp, ctx := errgroup.WithContext(r.Context())
+		grp.SetLimit(concurrency)
+		for _, d := range dates {
+			d := d
+			grp.Go(func() error {
+				stocks, err := g.Get(list, d)
+				res := dayResult{Date: dateString(d)}
+				if err != nil {
+					res.Error = err.Error()
+				} else {
+					res.Stocks = stocks
+				}
+				select {
+				case results <- res:
+				case <-ctx.Done():
+				}
+				return nil
+			})
+		}
+		go func() {
+			grp.Wait()
+			close(results)
+		}()
+		w.Header().Set(`content-type`, `application/x-ndjson`)
+		enc := json.NewEncoder(w)
+		flusher, _ := w.(http.Flusher)
+		for res := range results {
+			if err := enc.Encode(&res); err != nil {
+				return
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+	}
+}
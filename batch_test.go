@@ -0,0 +1,64 @@
+package movers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+)
+
+// flushCountingWriter wraps httptest.NewRecorder to count how many times
+// Flush is actually called, so a test can tell "the handler wrote all the
+// bytes" apart from "the handler flushed each record as it arrived".
+type flushCountingWriter struct {
+	*httptest.ResponseRecorder
+	flushes int
+}
+
+func (w *flushCountingWriter) Flush() {
+	w.flushes++
+	w.ResponseRecorder.Flush()
+}
+
+type rangeStubGetter struct{}
+
+func (rangeStubGetter) Get(list MoverList, d Date) ([]Stock, error) {
+	return []Stock{{Ticker: `AAPL`}}, nil
+}
+
+func init() {
+	RegisterSource(`rangestub`, func() Getter { return rangeStubGetter{} })
+}
+
+// TestHandleRange_FlushesEachRecordThroughAccessLogMiddleware exercises
+// handleRange the way production serves it, through NewHandlerWithOptions'
+// withAccessLog-wrapped statusRecorder. batch.go's `w.(http.Flusher)`
+// assertion silently failed once statusRecorder stopped promoting Flush,
+// so records only left the server whenever the internal write buffer
+// happened to fill - this confirms Flush is actually invoked per record.
+func TestHandleRange_FlushesEachRecordThroughAccessLogMiddleware(t *testing.T) {
+	router := mux.NewRouter()
+	h := NewHandlerWithOptions(context.Background(), router, Options{})
+
+	req := httptest.NewRequest(http.MethodGet, `/gainers?from=2021-01-04&to=2021-01-08&source=rangestub&concurrency=1`, nil)
+	w := &flushCountingWriter{ResponseRecorder: httptest.NewRecorder()}
+	h.ServeHTTP(w, req)
+
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	if len(lines) != 5 {
+		t.Fatalf(`expected 5 NDJSON records for the 5 weekdays in range, got %d: %q`, len(lines), w.Body.String())
+	}
+	for _, line := range lines {
+		var rec dayResult
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			t.Fatalf(`invalid NDJSON line %q: %s`, line, err)
+		}
+	}
+	if w.flushes < len(lines) {
+		t.Fatalf(`expected at least %d Flush calls (one per record), got %d`, len(lines), w.flushes)
+	}
+}
@@ -0,0 +1,71 @@
+package movers
+
+import (
+	"encoding/json"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const boltBucket = `movers`
+
+// boltStore persists mover data in a local BoltDB file. TTL is enforced at
+// read time by storing an expiration alongside the payload; a ttl of 0
+// means the entry never expires.
+type boltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore opens (creating if necessary) a BoltDB file at path for use
+// as a Store.
+func NewBoltStore(path string) (Store, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(boltBucket))
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &boltStore{db: db}, nil
+}
+
+type boltEntry struct {
+	Stocks    []Stock   `json:"stocks"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Infinite  bool      `json:"infinite"`
+}
+
+func (b *boltStore) Get(key string) (result []Stock, ok bool, err error) {
+	err = b.db.View(func(tx *bolt.Tx) error {
+		raw := tx.Bucket([]byte(boltBucket)).Get([]byte(key))
+		if raw == nil {
+			return nil
+		}
+		var e boltEntry
+		if err := json.Unmarshal(raw, &e); err != nil {
+			return err
+		}
+		if !e.Infinite && time.Now().After(e.ExpiresAt) {
+			return nil
+		}
+		result, ok = e.Stocks, true
+		return nil
+	})
+	return
+}
+
+func (b *boltStore) Put(key string, s []Stock, ttl time.Duration) error {
+	e := boltEntry{Stocks: s, ExpiresAt: time.Now().Add(ttl), Infinite: ttl == 0}
+	raw, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(boltBucket)).Put([]byte(key), raw)
+	})
+}
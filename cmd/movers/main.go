@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
 	"log"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/nycmonkey/movers"
+	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/crypto/acme/autocert"
 )
 
@@ -20,6 +22,13 @@ var (
 	hdlr http.Handler
 )
 
+// stdLogger adapts the standard library's log package to movers.Logger.
+type stdLogger struct{}
+
+func (stdLogger) Info(msg string, keyvals ...interface{}) {
+	log.Println(append([]interface{}{msg}, keyvals...)...)
+}
+
 func main() {
 	flag.StringVar(&fqdn, "n", `www.example.com`, `domain name for TLS cert`)
 	flag.Parse()
@@ -28,8 +37,20 @@ func main() {
 		HostPolicy: autocert.HostWhitelist(fqdn),
 		Cache:      autocert.DirCache(cacheDir()),
 	}
+	store, err := movers.NewBoltStore(filepath.Join(cacheDir(), `movers.db`))
+	if err != nil {
+		log.Fatalf(`opening mover cache failed with %s`, err)
+	}
+	alpaca := movers.NewAlpacaGetter()
+	stream, _ := alpaca.(movers.StreamSource)
 	mux := mux.NewRouter()
-	hdlr = movers.NewHandler(mux)
+	hdlr = movers.NewHandlerWithOptions(context.Background(), mux, movers.Options{
+		Registerer: prometheus.DefaultRegisterer,
+		Logger:     stdLogger{},
+		Store:      movers.NewCompositeStore(store),
+		Fallback:   alpaca,
+		Stream:     stream,
+	})
 	tlsServer := &http.Server{
 		Addr: ":https",
 		TLSConfig: &tls.Config{
@@ -53,7 +74,7 @@ func main() {
 		IdleTimeout:  120 * time.Second,
 		Handler:      m.HTTPHandler(hdlr),
 	}
-	err := httpServer.ListenAndServe()
+	err = httpServer.ListenAndServe()
 	if err != nil {
 		log.Fatalf(`ListenAndServe failed with %s`, err)
 	}
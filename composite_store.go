@@ -0,0 +1,36 @@
+package movers
+
+import "time"
+
+// compositeStore layers a fast in-memory Store (L1) in front of a
+// persistent Store (L2, e.g. Bolt/Redis/SQLite). Get is read-through: an L1
+// miss falls back to L2 and, on an L2 hit, repopulates L1. Put is
+// write-through: it updates both layers so the next Get anywhere is fast.
+type compositeStore struct {
+	l1 Store
+	l2 Store
+}
+
+// NewCompositeStore returns a Store that keeps an in-memory cache in front
+// of persistent, which is consulted only on an in-memory miss.
+func NewCompositeStore(persistent Store) Store {
+	return &compositeStore{l1: newMemoryStore(), l2: persistent}
+}
+
+func (c *compositeStore) Get(key string) (result []Stock, ok bool, err error) {
+	if result, ok, err = c.l1.Get(key); err != nil || ok {
+		return
+	}
+	if result, ok, err = c.l2.Get(key); err != nil || !ok {
+		return
+	}
+	err = c.l1.Put(key, result, 15*time.Minute)
+	return result, true, err
+}
+
+func (c *compositeStore) Put(key string, result []Stock, ttl time.Duration) error {
+	if err := c.l2.Put(key, result, ttl); err != nil {
+		return err
+	}
+	return c.l1.Put(key, result, ttl)
+}
@@ -0,0 +1,101 @@
+package movers
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type stubStore struct {
+	data     map[string][]Stock
+	getErr   error
+	putErr   error
+	getCalls int
+	putCalls int
+}
+
+func newStubStore() *stubStore {
+	return &stubStore{data: make(map[string][]Stock)}
+}
+
+func (s *stubStore) Get(key string) (result []Stock, ok bool, err error) {
+	s.getCalls++
+	if s.getErr != nil {
+		return nil, false, s.getErr
+	}
+	result, ok = s.data[key]
+	return result, ok, nil
+}
+
+func (s *stubStore) Put(key string, result []Stock, ttl time.Duration) error {
+	s.putCalls++
+	if s.putErr != nil {
+		return s.putErr
+	}
+	s.data[key] = result
+	return nil
+}
+
+func TestCompositeStore_L2HitRepopulatesL1(t *testing.T) {
+	l2 := newStubStore()
+	l2.data[`k`] = []Stock{{Ticker: `AAPL`}}
+	cs := NewCompositeStore(l2).(*compositeStore)
+
+	got, ok, err := cs.Get(`k`)
+	if err != nil || !ok {
+		t.Fatalf(`expected an L2 hit, got ok=%v err=%v`, ok, err)
+	}
+	if len(got) != 1 || got[0].Ticker != `AAPL` {
+		t.Fatalf(`got %+v`, got)
+	}
+
+	l1Got, l1ok, err := cs.l1.Get(`k`)
+	if err != nil || !l1ok {
+		t.Fatalf(`expected the L2 hit to repopulate L1, got ok=%v err=%v`, l1ok, err)
+	}
+	if len(l1Got) != 1 || l1Got[0].Ticker != `AAPL` {
+		t.Fatalf(`got %+v`, l1Got)
+	}
+}
+
+func TestCompositeStore_L1HitSkipsL2(t *testing.T) {
+	l2 := newStubStore()
+	cs := NewCompositeStore(l2).(*compositeStore)
+	if err := cs.l1.Put(`k`, []Stock{{Ticker: `MSFT`}}, time.Minute); err != nil {
+		t.Fatalf(`unexpected error priming L1: %s`, err)
+	}
+
+	got, ok, err := cs.Get(`k`)
+	if err != nil || !ok {
+		t.Fatalf(`unexpected ok=%v err=%v`, ok, err)
+	}
+	if len(got) != 1 || got[0].Ticker != `MSFT` {
+		t.Fatalf(`got %+v`, got)
+	}
+	if l2.getCalls != 0 {
+		t.Fatalf(`expected an L1 hit to skip L2 entirely, got %d L2.Get calls`, l2.getCalls)
+	}
+}
+
+func TestCompositeStore_PutWritesThroughBothLayers(t *testing.T) {
+	l2 := newStubStore()
+	cs := NewCompositeStore(l2).(*compositeStore)
+	if err := cs.Put(`k`, []Stock{{Ticker: `GOOG`}}, time.Minute); err != nil {
+		t.Fatalf(`unexpected error: %s`, err)
+	}
+	if l2.putCalls != 1 {
+		t.Fatalf(`expected Put to write through to L2, got %d calls`, l2.putCalls)
+	}
+	if _, ok, _ := cs.l1.Get(`k`); !ok {
+		t.Fatal(`expected Put to also populate L1`)
+	}
+}
+
+func TestCompositeStore_L2ErrorPropagatesOnL1Miss(t *testing.T) {
+	l2 := newStubStore()
+	l2.getErr = errors.New(`boom`)
+	cs := NewCompositeStore(l2).(*compositeStore)
+	if _, _, err := cs.Get(`k`); err == nil {
+		t.Fatal(`expected the L2 error to propagate`)
+	}
+}
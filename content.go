@@ -0,0 +1,109 @@
+package movers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/nycmonkey/movers/moverspb"
+)
+
+const (
+	formatJSON     = `json`
+	formatCSV      = `csv`
+	formatNDJSON   = `ndjson`
+	formatProtobuf = `protobuf`
+)
+
+// negotiateFormat picks a response format for r, honoring an explicit
+// ?format= override before falling back to the Accept header. It defaults
+// to JSON, matching the handlers' historical behavior.
+func negotiateFormat(r *http.Request) string {
+	switch r.URL.Query().Get(`format`) {
+	case formatCSV, `text/csv`:
+		return formatCSV
+	case formatNDJSON, `application/x-ndjson`:
+		return formatNDJSON
+	case formatProtobuf, `application/x-protobuf`:
+		return formatProtobuf
+	}
+	switch r.Header.Get(`Accept`) {
+	case `text/csv`:
+		return formatCSV
+	case `application/x-ndjson`:
+		return formatNDJSON
+	case `application/x-protobuf`:
+		return formatProtobuf
+	}
+	return formatJSON
+}
+
+// writeStocks encodes stocks in the format negotiated for r and writes it to
+// w with the matching content-type.
+func writeStocks(w http.ResponseWriter, r *http.Request, stocks []Stock) error {
+	switch negotiateFormat(r) {
+	case formatCSV:
+		return writeStocksCSV(w, stocks)
+	case formatNDJSON:
+		return writeStocksNDJSON(w, stocks)
+	case formatProtobuf:
+		return writeStocksProtobuf(w, stocks)
+	default:
+		w.Header().Set(`content-type`, `application/json`)
+		return json.NewEncoder(w).Encode(&stocks)
+	}
+}
+
+func writeStocksCSV(w http.ResponseWriter, stocks []Stock) error {
+	w.Header().Set(`content-type`, `text/csv`)
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{`ticker`, `instrument`, `price`, `percentChange`, `volume`}); err != nil {
+		return err
+	}
+	for _, s := range stocks {
+		row := []string{
+			s.Ticker,
+			s.Name,
+			strconv.FormatFloat(s.Price, 'f', -1, 64),
+			strconv.FormatFloat(s.PctChange, 'f', -1, 64),
+			strconv.Itoa(s.Volume),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeStocksNDJSON(w http.ResponseWriter, stocks []Stock) error {
+	w.Header().Set(`content-type`, `application/x-ndjson`)
+	enc := json.NewEncoder(w)
+	for _, s := range stocks {
+		if err := enc.Encode(&s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeStocksProtobuf(w http.ResponseWriter, stocks []Stock) error {
+	w.Header().Set(`content-type`, `application/x-protobuf`)
+	_, err := w.Write(stocksToProto(stocks).Marshal())
+	return err
+}
+
+func stocksToProto(stocks []Stock) *moverspb.StockList {
+	list := &moverspb.StockList{Stocks: make([]*moverspb.Stock, len(stocks))}
+	for i, s := range stocks {
+		list.Stocks[i] = &moverspb.Stock{
+			Ticker:        s.Ticker,
+			Instrument:    s.Name,
+			Price:         s.Price,
+			PercentChange: s.PctChange,
+			Volume:        int64(s.Volume),
+		}
+	}
+	return list
+}
@@ -0,0 +1,102 @@
+package movers
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/nycmonkey/movers/moverspb"
+)
+
+func TestNegotiateFormat(t *testing.T) {
+	cases := []struct {
+		name   string
+		query  string
+		accept string
+		want   string
+	}{
+		{name: `explicit format query wins`, query: `?format=csv`, accept: `application/x-ndjson`, want: formatCSV},
+		{name: `accept header honored absent query`, accept: `application/x-protobuf`, want: formatProtobuf},
+		{name: `defaults to json`, want: formatJSON},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := httptest.NewRequest(`GET`, `/gainers/today`+c.query, nil)
+			if c.accept != `` {
+				r.Header.Set(`Accept`, c.accept)
+			}
+			if got := negotiateFormat(r); got != c.want {
+				t.Fatalf(`got %q, want %q`, got, c.want)
+			}
+		})
+	}
+}
+
+func TestWriteStocksCSV(t *testing.T) {
+	stocks := []Stock{{Ticker: `AAPL`, Name: `Apple Inc`, Price: 123.45, PctChange: 1.01, Volume: 1234567}}
+	r := httptest.NewRequest(`GET`, `/gainers/today?format=csv`, nil)
+	w := httptest.NewRecorder()
+	if err := writeStocks(w, r, stocks); err != nil {
+		t.Fatalf(`unexpected error: %s`, err)
+	}
+	if ct := w.Header().Get(`content-type`); ct != `text/csv` {
+		t.Fatalf(`got content-type %q`, ct)
+	}
+	rows, err := csv.NewReader(w.Body).ReadAll()
+	if err != nil {
+		t.Fatalf(`invalid CSV: %s`, err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf(`expected a header row plus 1 data row, got %d`, len(rows))
+	}
+	if rows[1][0] != `AAPL` {
+		t.Fatalf(`got row %v`, rows[1])
+	}
+}
+
+func TestWriteStocksNDJSON(t *testing.T) {
+	stocks := []Stock{{Ticker: `AAPL`}, {Ticker: `MSFT`}}
+	r := httptest.NewRequest(`GET`, `/gainers/today?format=ndjson`, nil)
+	w := httptest.NewRecorder()
+	if err := writeStocks(w, r, stocks); err != nil {
+		t.Fatalf(`unexpected error: %s`, err)
+	}
+	scanner := bufio.NewScanner(strings.NewReader(w.Body.String()))
+	var got []Stock
+	for scanner.Scan() {
+		var s Stock
+		if err := json.Unmarshal(scanner.Bytes(), &s); err != nil {
+			t.Fatalf(`invalid NDJSON line %q: %s`, scanner.Text(), err)
+		}
+		got = append(got, s)
+	}
+	if len(got) != 2 || got[0].Ticker != `AAPL` || got[1].Ticker != `MSFT` {
+		t.Fatalf(`got %+v`, got)
+	}
+}
+
+func TestWriteStocksProtobuf(t *testing.T) {
+	stocks := []Stock{{Ticker: `AAPL`, Name: `Apple Inc`, Price: 123.45, PctChange: 1.01, Volume: 1234567}}
+	r := httptest.NewRequest(`GET`, `/gainers/today?format=protobuf`, nil)
+	w := httptest.NewRecorder()
+	if err := writeStocks(w, r, stocks); err != nil {
+		t.Fatalf(`unexpected error: %s`, err)
+	}
+	if ct := w.Header().Get(`content-type`); ct != `application/x-protobuf` {
+		t.Fatalf(`got content-type %q`, ct)
+	}
+	var list moverspb.StockList
+	if err := list.Unmarshal(w.Body.Bytes()); err != nil {
+		t.Fatalf(`failed to unmarshal wire bytes: %s`, err)
+	}
+	if len(list.Stocks) != 1 {
+		t.Fatalf(`got %d stocks, want 1`, len(list.Stocks))
+	}
+	got := list.Stocks[0]
+	if got.Ticker != `AAPL` || got.Instrument != `Apple Inc` || got.Price != 123.45 || got.PercentChange != 1.01 || got.Volume != 1234567 {
+		t.Fatalf(`got %+v`, got)
+	}
+}
@@ -0,0 +1,65 @@
+package movers
+
+import (
+	"errors"
+	"log"
+	"sync"
+)
+
+// fallbackThreshold is how many consecutive parse failures against today's
+// date trip the switch to the fallback source.
+const fallbackThreshold = 3
+
+// FallbackGetter wraps a primary Getter (typically the WSJ-scraping one)
+// and, once it has failed to parse today's date fallbackThreshold times in
+// a row, switches to a fallback Getter (e.g. an Alpaca/IEX-backed source)
+// for today's date going forward, logging a schema-drift warning that
+// identifies the fingerprint of the page layout that broke. Historical
+// dates are always served by primary, since they're expected to already be
+// cached and a fallback source typically can't serve them anyway.
+type FallbackGetter struct {
+	primary  Getter
+	fallback Getter
+
+	mu            sync.Mutex
+	consecutive   int
+	usingFallback bool
+}
+
+// NewFallbackGetter returns a Getter that falls back from primary to
+// fallback after repeated parse failures on today's date.
+func NewFallbackGetter(primary, fallback Getter) *FallbackGetter {
+	return &FallbackGetter{primary: primary, fallback: fallback}
+}
+
+func (f *FallbackGetter) Get(list MoverList, d Date) (result []Stock, err error) {
+	if !isToday(d) {
+		return f.primary.Get(list, d)
+	}
+	f.mu.Lock()
+	useFallback := f.usingFallback
+	f.mu.Unlock()
+	if useFallback {
+		return f.fallback.Get(list, d)
+	}
+	result, err = f.primary.Get(list, d)
+	var perr *ParseError
+	if !errors.As(err, &perr) {
+		f.mu.Lock()
+		f.consecutive = 0
+		f.mu.Unlock()
+		return result, err
+	}
+	f.mu.Lock()
+	f.consecutive++
+	trip := f.consecutive >= fallbackThreshold
+	if trip {
+		f.usingFallback = true
+	}
+	f.mu.Unlock()
+	if !trip {
+		return result, err
+	}
+	log.Printf(`movers: schema drift detected (fingerprint %s) on %s, switching to fallback source`, perr.Fingerprint, dateString(d))
+	return f.fallback.Get(list, d)
+}
@@ -0,0 +1,90 @@
+package movers
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func todayForTest() Date {
+	now := time.Now()
+	return Date{Year: now.Year(), Month: now.Month(), Day: now.Day()}
+}
+
+type stubGetter struct {
+	stocks []Stock
+	err    error
+	calls  int
+}
+
+func (s *stubGetter) Get(list MoverList, d Date) ([]Stock, error) {
+	s.calls++
+	return s.stocks, s.err
+}
+
+func TestFallbackGetter_TripsAfterRepeatedParseFailures(t *testing.T) {
+	primary := &stubGetter{err: &ParseError{Err: errors.New(`boom`), Fingerprint: `abc123`}}
+	fallback := &stubGetter{stocks: []Stock{{Ticker: `AAPL`}}}
+	f := NewFallbackGetter(primary, fallback)
+	d := todayForTest()
+
+	for i := 0; i < fallbackThreshold-1; i++ {
+		if _, err := f.Get(USCompositeGainers, d); !errors.As(err, new(*ParseError)) {
+			t.Fatalf(`call %d: expected a *ParseError while under threshold, got %v`, i, err)
+		}
+	}
+	if primary.calls != fallbackThreshold-1 {
+		t.Fatalf(`expected %d primary calls, got %d`, fallbackThreshold-1, primary.calls)
+	}
+
+	stocks, err := f.Get(USCompositeGainers, d)
+	if err != nil {
+		t.Fatalf(`expected the tripping call to fall back without error, got %s`, err)
+	}
+	if len(stocks) != 1 || stocks[0].Ticker != `AAPL` {
+		t.Fatalf(`expected fallback's stocks, got %+v`, stocks)
+	}
+
+	primary.calls = 0
+	if _, err := f.Get(USCompositeGainers, d); err != nil {
+		t.Fatalf(`unexpected error after tripping: %s`, err)
+	}
+	if primary.calls != 0 {
+		t.Fatalf(`expected primary not to be called once fallback has tripped, got %d calls`, primary.calls)
+	}
+}
+
+func TestFallbackGetter_ResetsCounterOnSuccess(t *testing.T) {
+	primary := &stubGetter{err: &ParseError{Err: errors.New(`boom`), Fingerprint: `abc123`}}
+	fallback := &stubGetter{stocks: []Stock{{Ticker: `AAPL`}}}
+	f := NewFallbackGetter(primary, fallback)
+	d := todayForTest()
+
+	if _, err := f.Get(USCompositeGainers, d); err == nil {
+		t.Fatal(`expected an error`)
+	}
+	primary.err = nil
+	primary.stocks = []Stock{{Ticker: `MSFT`}}
+	if _, err := f.Get(USCompositeGainers, d); err != nil {
+		t.Fatalf(`unexpected error: %s`, err)
+	}
+	if f.consecutive != 0 {
+		t.Fatalf(`expected consecutive failure count to reset on success, got %d`, f.consecutive)
+	}
+}
+
+func TestFallbackGetter_IgnoresNonParseErrors(t *testing.T) {
+	primary := &stubGetter{err: errors.New(`network is down`)}
+	fallback := &stubGetter{stocks: []Stock{{Ticker: `AAPL`}}}
+	f := NewFallbackGetter(primary, fallback)
+	d := todayForTest()
+
+	for i := 0; i < fallbackThreshold+2; i++ {
+		if _, err := f.Get(USCompositeGainers, d); err == nil || errors.As(err, new(*ParseError)) {
+			t.Fatalf(`call %d: expected the raw non-parse error, got %v`, i, err)
+		}
+	}
+	if fallback.calls != 0 {
+		t.Fatalf(`non-parse errors should never trip the fallback, got %d fallback calls`, fallback.calls)
+	}
+}
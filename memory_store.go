@@ -0,0 +1,48 @@
+package movers
+
+import (
+	"sync"
+	"time"
+)
+
+// memoryStore is a process-local Store, the same behavior NewGetter offered
+// before pluggable Store backends existed. Data does not survive restarts.
+type memoryStore struct {
+	sync.Mutex
+	data map[string]memoryEntry
+}
+
+type memoryEntry struct {
+	stocks    []Stock
+	expiresAt time.Time
+	infinite  bool
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{data: make(map[string]memoryEntry)}
+}
+
+func (m *memoryStore) Get(key string) (result []Stock, ok bool, err error) {
+	m.Lock()
+	defer m.Unlock()
+	e, found := m.data[key]
+	if !found {
+		return nil, false, nil
+	}
+	if !e.infinite && time.Now().After(e.expiresAt) {
+		delete(m.data, key)
+		return nil, false, nil
+	}
+	return e.stocks, true, nil
+}
+
+func (m *memoryStore) Put(key string, s []Stock, ttl time.Duration) error {
+	m.Lock()
+	defer m.Unlock()
+	m.data[key] = memoryEntry{
+		stocks:    s,
+		expiresAt: time.Now().Add(ttl),
+		infinite:  ttl == 0,
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package movers
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Metrics holds the Prometheus collectors instrumenting the Getter and HTTP
+// server. Use NewMetrics to register them against a Registerer; a nil
+// *Metrics is safe to use and simply records nothing, so callers that don't
+// care about metrics can pass one around without nil-checking it themselves.
+type Metrics struct {
+	upstreamFetchDuration *prometheus.HistogramVec
+	parseErrors           *prometheus.CounterVec
+	cacheResults          *prometheus.CounterVec
+	requestsTotal         *prometheus.CounterVec
+}
+
+// NewMetrics registers the movers package's collectors with reg and returns
+// the handle used to record observations.
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	factory := promauto.With(reg)
+	return &Metrics{
+		upstreamFetchDuration: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: `movers`,
+			Name:      `upstream_fetch_duration_seconds`,
+			Help:      `Latency of fetching and parsing a mover list from its upstream source.`,
+			Buckets:   prometheus.DefBuckets,
+		}, []string{`list`}),
+		parseErrors: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: `movers`,
+			Name:      `parse_errors_total`,
+			Help:      `Count of errors encountered fetching or parsing an upstream response.`,
+		}, []string{`list`}),
+		cacheResults: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: `movers`,
+			Name:      `cache_requests_total`,
+			Help:      `Count of Store lookups, labeled by whether they hit.`,
+		}, []string{`result`}),
+		requestsTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: `movers`,
+			Name:      `http_requests_total`,
+			Help:      `Count of HTTP requests, labeled by route and status.`,
+		}, []string{`route`, `status`}),
+	}
+}
+
+func (m *Metrics) observeFetch(list MoverList, seconds float64, err error) {
+	if m == nil {
+		return
+	}
+	m.upstreamFetchDuration.WithLabelValues(string(list)).Observe(seconds)
+	if err != nil {
+		m.parseErrors.WithLabelValues(string(list)).Inc()
+	}
+}
+
+func (m *Metrics) observeCacheResult(hit bool) {
+	if m == nil {
+		return
+	}
+	result := `miss`
+	if hit {
+		result = `hit`
+	}
+	m.cacheResults.WithLabelValues(result).Inc()
+}
+
+func (m *Metrics) observeRequest(route string, status int) {
+	if m == nil {
+		return
+	}
+	m.requestsTotal.WithLabelValues(route, strconv.Itoa(status)).Inc()
+}
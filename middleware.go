@@ -0,0 +1,107 @@
+package movers
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Logger is satisfied by structured loggers such as *slog.Logger; movers
+// only needs the ability to log a message with alternating key/value pairs.
+// zerolog.Logger's Info method has a different signature, so it needs an
+// adapter to be used here.
+type Logger interface {
+	Info(msg string, keyvals ...interface{})
+}
+
+type contextKey string
+
+const requestIDKey contextKey = `requestID`
+
+// RequestIDFromContext returns the ID the requestID middleware stamped onto
+// the request, or "" if none is present.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return hex.EncodeToString(b)
+}
+
+// withRequestID stamps each request with a unique ID, echoed in the
+// X-Request-Id response header and retrievable via RequestIDFromContext.
+func withRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := newRequestID()
+		w.Header().Set(`X-Request-Id`, id)
+		ctx := context.WithValue(r.Context(), requestIDKey, id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// statusRecorder captures the status code written to an http.ResponseWriter
+// so middleware can observe it after the handler returns.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(code int) {
+	s.status = code
+	s.ResponseWriter.WriteHeader(code)
+}
+
+// Hijack lets statusRecorder satisfy http.Hijacker by delegating to the
+// wrapped ResponseWriter, so middleware built on statusRecorder (such as
+// withAccessLog) doesn't break the WebSocket upgrade in serveStream:
+// embedding http.ResponseWriter as an interface only promotes the methods
+// that interface declares, and http.Hijacker isn't one of them.
+func (s *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hj, ok := s.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf(`movers: underlying ResponseWriter does not implement http.Hijacker`)
+	}
+	return hj.Hijack()
+}
+
+// Flush lets statusRecorder satisfy http.Flusher by delegating to the
+// wrapped ResponseWriter, for the same reason Hijack does: handlers like
+// handleRange that type-assert for http.Flusher need it to survive being
+// wrapped for access logging.
+func (s *statusRecorder) Flush() {
+	if f, ok := s.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// withAccessLog logs each request's method, path, status and duration to
+// logger (if non-nil) and records it against m as a per-route/status
+// counter (m may be nil).
+func withAccessLog(next http.Handler, logger Logger, m *Metrics) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+		next.ServeHTTP(rec, r)
+		m.observeRequest(r.URL.Path, rec.status)
+		if logger != nil {
+			logger.Info(`request`,
+				`requestId`, RequestIDFromContext(r.Context()),
+				`method`, r.Method,
+				`path`, r.URL.Path,
+				`status`, rec.status,
+				`duration`, time.Since(start).String(),
+			)
+		}
+	})
+}
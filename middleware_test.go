@@ -0,0 +1,49 @@
+package movers
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// hijackableRecorder adds a Hijack method to httptest.ResponseRecorder, the
+// way the *http.response underneath a real server supports it.
+type hijackableRecorder struct {
+	*httptest.ResponseRecorder
+}
+
+func (h *hijackableRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+func TestStatusRecorder_FlushDelegatesToUnderlyingWriter(t *testing.T) {
+	rec := httptest.NewRecorder()
+	sr := &statusRecorder{ResponseWriter: rec, status: http.StatusOK}
+	if _, ok := interface{}(sr).(http.Flusher); !ok {
+		t.Fatal(`statusRecorder no longer implements http.Flusher`)
+	}
+	sr.Flush()
+	if !rec.Flushed {
+		t.Fatal(`expected Flush to be forwarded to the wrapped ResponseWriter`)
+	}
+}
+
+func TestStatusRecorder_HijackDelegatesWhenSupported(t *testing.T) {
+	base := &hijackableRecorder{httptest.NewRecorder()}
+	sr := &statusRecorder{ResponseWriter: base, status: http.StatusOK}
+	if _, ok := interface{}(sr).(http.Hijacker); !ok {
+		t.Fatal(`statusRecorder no longer implements http.Hijacker`)
+	}
+	if _, _, err := sr.Hijack(); err != nil {
+		t.Fatalf(`unexpected error: %s`, err)
+	}
+}
+
+func TestStatusRecorder_HijackErrorsWhenUnderlyingWriterCannot(t *testing.T) {
+	sr := &statusRecorder{ResponseWriter: httptest.NewRecorder(), status: http.StatusOK}
+	if _, _, err := sr.Hijack(); err == nil {
+		t.Fatal(`expected an error when the wrapped ResponseWriter is not a Hijacker`)
+	}
+}
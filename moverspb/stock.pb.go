@@ -0,0 +1,226 @@
+// Package moverspb implements the proto3 wire format described by
+// stock.proto, by hand rather than via protoc/protoc-gen-go: there's no
+// generator wired into this repo yet, so there's nothing to regenerate
+// from. It covers exactly the scalar and length-delimited field types
+// stock.proto uses today (string, double, int64, and one level of
+// repeated message); it does not implement proto.Message, reflection,
+// unknown-field preservation, or anything else a real protoc-gen-go
+// output would give you. If stock.proto gains a field or message shape
+// this file doesn't handle, update Marshal/Unmarshal here by hand, or
+// replace this package with real protoc-gen-go output and wire that
+// generator into the build.
+package moverspb
+
+import (
+	"errors"
+	"math"
+)
+
+// Stock mirrors movers.Stock for protobuf wire transfer.
+type Stock struct {
+	Ticker        string
+	Instrument    string
+	Price         float64
+	PercentChange float64
+	Volume        int64
+}
+
+// StockList is the wire envelope for a mover list response.
+type StockList struct {
+	Stocks []*Stock
+}
+
+const (
+	fieldTicker = 1
+	fieldName   = 2
+	fieldPrice  = 3
+	fieldPctChg = 4
+	fieldVolume = 5
+
+	fieldListStocks = 1
+)
+
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+)
+
+func appendVarint(buf []byte, field int, v uint64) []byte {
+	buf = appendTag(buf, field, wireVarint)
+	for v >= 0x80 {
+		buf = append(buf, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(buf, byte(v))
+}
+
+func appendFixed64(buf []byte, field int, v uint64) []byte {
+	buf = appendTag(buf, field, wireFixed64)
+	for i := 0; i < 8; i++ {
+		buf = append(buf, byte(v))
+		v >>= 8
+	}
+	return buf
+}
+
+func appendBytes(buf []byte, field int, v []byte) []byte {
+	buf = appendTag(buf, field, wireBytes)
+	buf = appendVarintRaw(buf, uint64(len(v)))
+	return append(buf, v...)
+}
+
+func appendTag(buf []byte, field, wire int) []byte {
+	return appendVarintRaw(buf, uint64(field)<<3|uint64(wire))
+}
+
+func appendVarintRaw(buf []byte, v uint64) []byte {
+	for v >= 0x80 {
+		buf = append(buf, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(buf, byte(v))
+}
+
+// Marshal encodes s using the proto3 wire format described in stock.proto.
+func (s *Stock) Marshal() []byte {
+	var buf []byte
+	if s.Ticker != "" {
+		buf = appendBytes(buf, fieldTicker, []byte(s.Ticker))
+	}
+	if s.Instrument != "" {
+		buf = appendBytes(buf, fieldName, []byte(s.Instrument))
+	}
+	if s.Price != 0 {
+		buf = appendFixed64(buf, fieldPrice, math.Float64bits(s.Price))
+	}
+	if s.PercentChange != 0 {
+		buf = appendFixed64(buf, fieldPctChg, math.Float64bits(s.PercentChange))
+	}
+	if s.Volume != 0 {
+		buf = appendVarint(buf, fieldVolume, uint64(s.Volume))
+	}
+	return buf
+}
+
+// Unmarshal decodes a Stock from its proto3 wire format.
+func (s *Stock) Unmarshal(data []byte) error {
+	for len(data) > 0 {
+		field, wire, n, err := readTag(data)
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+		switch wire {
+		case wireVarint:
+			v, n, err := readVarint(data)
+			if err != nil {
+				return err
+			}
+			data = data[n:]
+			if field == fieldVolume {
+				s.Volume = int64(v)
+			}
+		case wireFixed64:
+			if len(data) < 8 {
+				return errors.New(`moverspb: truncated fixed64`)
+			}
+			v := readFixed64(data)
+			data = data[8:]
+			switch field {
+			case fieldPrice:
+				s.Price = math.Float64frombits(v)
+			case fieldPctChg:
+				s.PercentChange = math.Float64frombits(v)
+			}
+		case wireBytes:
+			v, n, err := readBytes(data)
+			if err != nil {
+				return err
+			}
+			data = data[n:]
+			switch field {
+			case fieldTicker:
+				s.Ticker = string(v)
+			case fieldName:
+				s.Instrument = string(v)
+			}
+		default:
+			return errors.New(`moverspb: unsupported wire type`)
+		}
+	}
+	return nil
+}
+
+// Marshal encodes l as a length-delimited sequence of Stock sub-messages.
+func (l *StockList) Marshal() []byte {
+	var buf []byte
+	for _, s := range l.Stocks {
+		buf = appendBytes(buf, fieldListStocks, s.Marshal())
+	}
+	return buf
+}
+
+// Unmarshal decodes a StockList from its proto3 wire format.
+func (l *StockList) Unmarshal(data []byte) error {
+	for len(data) > 0 {
+		field, wire, n, err := readTag(data)
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+		if wire != wireBytes || field != fieldListStocks {
+			return errors.New(`moverspb: unexpected field in StockList`)
+		}
+		raw, n, err := readBytes(data)
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+		var s Stock
+		if err := s.Unmarshal(raw); err != nil {
+			return err
+		}
+		l.Stocks = append(l.Stocks, &s)
+	}
+	return nil
+}
+
+func readVarint(data []byte) (v uint64, n int, err error) {
+	for shift := uint(0); n < len(data); shift += 7 {
+		b := data[n]
+		n++
+		v |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return v, n, nil
+		}
+	}
+	return 0, 0, errors.New(`moverspb: truncated varint`)
+}
+
+func readTag(data []byte) (field int, wire int, n int, err error) {
+	v, n, err := readVarint(data)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return int(v >> 3), int(v & 0x7), n, nil
+}
+
+func readFixed64(data []byte) (v uint64) {
+	for i := 7; i >= 0; i-- {
+		v = v<<8 | uint64(data[i])
+	}
+	return v
+}
+
+func readBytes(data []byte) (v []byte, n int, err error) {
+	length, ln, err := readVarint(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	end := ln + int(length)
+	if end > len(data) {
+		return nil, 0, errors.New(`moverspb: truncated length-delimited field`)
+	}
+	return data[ln:end], end, nil
+}
@@ -0,0 +1,35 @@
+package moverspb
+
+import "testing"
+
+func TestStockMarshalUnmarshalRoundTrip(t *testing.T) {
+	want := Stock{Ticker: `AAPL`, Instrument: `Apple Inc`, Price: 123.45, PercentChange: 1.01, Volume: 1234567}
+	var got Stock
+	if err := got.Unmarshal(want.Marshal()); err != nil {
+		t.Fatalf(`unexpected error: %s`, err)
+	}
+	if got != want {
+		t.Fatalf(`got %+v, want %+v`, got, want)
+	}
+}
+
+func TestStockListMarshalUnmarshalRoundTrip(t *testing.T) {
+	want := StockList{Stocks: []*Stock{
+		{Ticker: `AAPL`, Price: 123.45},
+		{Ticker: `MSFT`, Price: 456.78},
+	}}
+	var got StockList
+	if err := got.Unmarshal(want.Marshal()); err != nil {
+		t.Fatalf(`unexpected error: %s`, err)
+	}
+	if len(got.Stocks) != 2 || *got.Stocks[0] != *want.Stocks[0] || *got.Stocks[1] != *want.Stocks[1] {
+		t.Fatalf(`got %+v`, got.Stocks)
+	}
+}
+
+func TestStockUnmarshalTruncatedFixed64IsError(t *testing.T) {
+	var s Stock
+	if err := s.Unmarshal([]byte{0x19, 0x01, 0x02}); err == nil {
+		t.Fatal(`expected an error for a truncated fixed64 field`)
+	}
+}
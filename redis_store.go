@@ -0,0 +1,44 @@
+package movers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// redisStore persists mover data in Redis, relying on Redis' native key
+// expiration to age out current-day entries. A ttl of 0 (historical dates)
+// is passed straight through, which redis.Client.Set treats as "no expiry".
+type redisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore returns a Store backed by the Redis instance at addr.
+func NewRedisStore(addr string) Store {
+	return &redisStore{client: redis.NewClient(&redis.Options{Addr: addr})}
+}
+
+func (r *redisStore) Get(key string) (result []Stock, ok bool, err error) {
+	raw, err := r.client.Get(context.Background(), key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if err = json.Unmarshal(raw, &result); err != nil {
+		return nil, false, err
+	}
+	return result, true, nil
+}
+
+func (r *redisStore) Put(key string, s []Stock, ttl time.Duration) error {
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return r.client.Set(context.Background(), key, raw, ttl).Err()
+}
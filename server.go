@@ -1,22 +1,42 @@
 package movers
 
 import (
-	"encoding/json"
+	"context"
 	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 type server struct {
 	g      Getter
 	router *mux.Router
+	hub    *hub
 }
 
 func (s *server) routes() {
+	s.router.HandleFunc("/gainers/today", s.handleGainersToday())
+	s.router.HandleFunc("/losers/today", s.handleLosersToday())
 	s.router.HandleFunc("/gainers/{year:20[0-9]{2}}-{month:[01]?[0-9]}-{day:[0-3]?[0-9]}", s.handleGainers())
 	s.router.HandleFunc("/losers/{year:20[0-9]{2}}-{month:[01]?[0-9]}-{day:[0-3]?[0-9]}", s.handleLosers())
+	s.router.HandleFunc("/gainers", s.handleRange(USCompositeGainers)).Queries(`from`, `{from}`, `to`, `{to}`)
+	s.router.HandleFunc("/losers", s.handleRange(USCompositeLosers)).Queries(`from`, `{from}`, `to`, `{to}`)
+	s.router.HandleFunc("/stream/gainers", s.serveStream(USCompositeGainers))
+	s.router.HandleFunc("/stream/losers", s.serveStream(USCompositeLosers))
+}
+
+// sourceFor resolves the Getter to use for a request, honoring an optional
+// ?source= query param (e.g. "wsj", "alpaca"). It falls back to the server's
+// default Getter when the param is absent.
+func (s *server) sourceFor(r *http.Request) (Getter, error) {
+	name := r.URL.Query().Get(`source`)
+	if name == "" {
+		return s.g, nil
+	}
+	return NewSource(name)
 }
 
 func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -25,12 +45,84 @@ func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 // NewHandler returns an http handler that responds to requests for top stock gainers and losers by date
 func NewHandler(router *mux.Router) http.Handler {
+	return NewHandlerWithOptions(context.Background(), router, Options{})
+}
+
+// NewStreamingHandler returns an http.Handler like NewHandler, additionally
+// serving /stream/gainers and /stream/losers WebSocket routes that push
+// live updates from src for as long as ctx remains uncancelled.
+func NewStreamingHandler(ctx context.Context, router *mux.Router, src StreamSource) http.Handler {
+	return NewHandlerWithOptions(ctx, router, Options{Stream: src})
+}
+
+// Options configures NewHandlerWithOptions.
+type Options struct {
+	// Registerer receives the package's Prometheus collectors. If nil, a
+	// private registry is used and served at /metrics.
+	Registerer prometheus.Registerer
+	// Logger receives a structured access log entry per request. A nil
+	// Logger disables access logging.
+	Logger Logger
+	// Store backs the Getter's cache. Defaults to an in-memory Store.
+	Store Store
+	// Fallback, if non-nil, wraps the Store-backed Getter in a
+	// FallbackGetter that fails over to it for today's date after
+	// repeated upstream ParseErrors.
+	Fallback Getter
+	// Stream, if non-nil, additionally serves /stream/gainers and
+	// /stream/losers WebSocket routes pushing live updates from this
+	// source until the ctx passed to NewHandlerWithOptions is canceled.
+	Stream StreamSource
+}
+
+// newGetterForOptions builds the Getter NewHandlerWithOptions serves from,
+// wrapping the Store-backed Getter in a FallbackGetter when fallback is
+// non-nil.
+func newGetterForOptions(store Store, m *Metrics, fallback Getter) Getter {
+	g := NewGetterWithOptions(store, m)
+	if fallback == nil {
+		return g
+	}
+	return NewFallbackGetter(g, fallback)
+}
+
+// NewHandlerWithOptions is the one constructor behind NewHandler,
+// NewStreamingHandler and every other way of building a movers handler, so
+// Store, Registerer/Logger and Stream can all be wired in together instead
+// of forcing a choice between them. It wires Prometheus metrics (served at
+// /metrics), a request-ID middleware, and structured access logging as
+// configured by opts. This is the precondition for running the service
+// behind autocert in production: it's what lets an operator alert when
+// parseTable starts failing.
+func NewHandlerWithOptions(ctx context.Context, router *mux.Router, opts Options) http.Handler {
+	reg := opts.Registerer
+	var gatherer prometheus.Gatherer
+	if reg == nil {
+		r := prometheus.NewRegistry()
+		reg, gatherer = r, r
+	} else if g, ok := reg.(prometheus.Gatherer); ok {
+		gatherer = g
+	}
+	m := NewMetrics(reg)
+	store := opts.Store
+	if store == nil {
+		store = newMemoryStore()
+	}
 	s := server{
-		g:      NewGetter(),
+		g:      newGetterForOptions(store, m, opts.Fallback),
 		router: router,
 	}
+	if opts.Stream != nil {
+		s.hub = newHub()
+	}
 	s.routes()
-	return &s
+	if opts.Stream != nil {
+		go s.hub.run(ctx, opts.Stream)
+	}
+	if gatherer != nil {
+		router.Handle(`/metrics`, promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
+	}
+	return withRequestID(withAccessLog(&s, opts.Logger, m))
 }
 
 func (s *server) handleGainers() http.HandlerFunc {
@@ -39,26 +131,63 @@ func (s *server) handleGainers() http.HandlerFunc {
 		year, err := strconv.Atoi(vars[`year`])
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
 		}
 		month, err := strconv.Atoi(vars[`month`])
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
 		}
 		day, err := strconv.Atoi(vars[`day`])
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
 		}
 		var d Date
 		d, err = NewDate(year, time.Month(month), day)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		g, err := s.sourceFor(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
 		}
-		stocks, err := s.g.Get(USCompositeGainers, d)
+		stocks, err := g.Get(USCompositeGainers, d)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusFailedDependency)
+			return
+		}
+		if err := writeStocks(w, r, stocks); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+// handleGainersToday serves today's gainers from a live-capable source
+// (?source=alpaca, etc.), bypassing the weekday/weekend Date.Validate
+// restriction that applies to the historical WSJ archive.
+func (s *server) handleGainersToday() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		g, err := s.sourceFor(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		live, ok := g.(LiveGetter)
+		if !ok {
+			http.Error(w, `selected source does not support live data`, http.StatusBadRequest)
+			return
+		}
+		stocks, err := live.GetLive(USCompositeGainers)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusFailedDependency)
+			return
+		}
+		if err := writeStocks(w, r, stocks); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
-		w.Header().Set(`content-type`, `application/json`)
-		json.NewEncoder(w).Encode(&stocks)
 	}
 }
 
@@ -68,25 +197,62 @@ func (s *server) handleLosers() http.HandlerFunc {
 		year, err := strconv.Atoi(vars[`year`])
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
 		}
 		month, err := strconv.Atoi(vars[`month`])
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
 		}
 		day, err := strconv.Atoi(vars[`day`])
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
 		}
 		var d Date
 		d, err = NewDate(year, time.Month(month), day)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		g, err := s.sourceFor(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		stocks, err := g.Get(USCompositeLosers, d)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusFailedDependency)
+			return
+		}
+		if err := writeStocks(w, r, stocks); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+// handleLosersToday serves today's losers from a live-capable source
+// (?source=alpaca, etc.), bypassing the weekday/weekend Date.Validate
+// restriction that applies to the historical WSJ archive.
+func (s *server) handleLosersToday() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		g, err := s.sourceFor(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		live, ok := g.(LiveGetter)
+		if !ok {
+			http.Error(w, `selected source does not support live data`, http.StatusBadRequest)
+			return
 		}
-		stocks, err := s.g.Get(USCompositeLosers, d)
+		stocks, err := live.GetLive(USCompositeLosers)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusFailedDependency)
+			return
+		}
+		if err := writeStocks(w, r, stocks); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
-		w.Header().Set(`content-type`, `application/json`)
-		json.NewEncoder(w).Encode(&stocks)
 	}
 }
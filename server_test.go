@@ -0,0 +1,22 @@
+package movers
+
+import "testing"
+
+func TestNewGetterForOptions_NoFallbackReturnsBareCache(t *testing.T) {
+	g := newGetterForOptions(newMemoryStore(), nil, nil)
+	if _, ok := g.(*FallbackGetter); ok {
+		t.Fatal(`expected no FallbackGetter wrapping when Fallback is unset`)
+	}
+}
+
+func TestNewGetterForOptions_WrapsWithFallbackWhenProvided(t *testing.T) {
+	fallback := &stubGetter{}
+	g := newGetterForOptions(newMemoryStore(), nil, fallback)
+	fg, ok := g.(*FallbackGetter)
+	if !ok {
+		t.Fatalf(`expected a *FallbackGetter, got %T`, g)
+	}
+	if fg.fallback != Getter(fallback) {
+		t.Fatal(`expected the FallbackGetter's fallback field to be the provided Getter`)
+	}
+}
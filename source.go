@@ -0,0 +1,66 @@
+package movers
+
+import (
+	"fmt"
+	"sync"
+)
+
+// SourceFactory constructs a new Getter backend. Factories are registered by
+// name via RegisterSource so callers can select one at runtime, similar to
+// how database/sql drivers are registered.
+type SourceFactory func() Getter
+
+var (
+	sourcesMu sync.Mutex
+	sources   = make(map[string]SourceFactory)
+	instances = make(map[string]Getter)
+)
+
+// RegisterSource makes a Getter implementation available under name for later
+// lookup via NewSource. It is intended to be called from the init function of
+// a package implementing Getter. If RegisterSource is called twice with the
+// same name, or if factory is nil, it panics.
+func RegisterSource(name string, factory SourceFactory) {
+	if factory == nil {
+		panic(`movers: RegisterSource factory is nil`)
+	}
+	sourcesMu.Lock()
+	defer sourcesMu.Unlock()
+	if _, dup := sources[name]; dup {
+		panic(`movers: RegisterSource called twice for source ` + name)
+	}
+	sources[name] = factory
+}
+
+// NewSource returns the shared Getter for the named source, constructing it
+// lazily on first use from the factory registered with RegisterSource.
+// Repeated calls with the same name return the same instance, so a source's
+// own caching and request-coalescing (e.g. the wsj source's Store) actually
+// has a chance to do its job across requests.
+func NewSource(name string) (Getter, error) {
+	sourcesMu.Lock()
+	defer sourcesMu.Unlock()
+	if g, ok := instances[name]; ok {
+		return g, nil
+	}
+	factory, ok := sources[name]
+	if !ok {
+		return nil, fmt.Errorf(`movers: unknown source %q`, name)
+	}
+	g := factory()
+	instances[name] = g
+	return g, nil
+}
+
+// LiveGetter is implemented by sources that can return intraday mover data
+// for the current session without a historical Date, bypassing the
+// weekday/weekend restriction imposed by Date.Validate.
+type LiveGetter interface {
+	GetLive(list MoverList) (result []Stock, err error)
+}
+
+func init() {
+	RegisterSource(`wsj`, func() Getter {
+		return NewGetter()
+	})
+}
@@ -0,0 +1,70 @@
+package movers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const sqliteSchema = `CREATE TABLE IF NOT EXISTS movers_cache (
+	key TEXT PRIMARY KEY,
+	stocks TEXT NOT NULL,
+	expires_at DATETIME NOT NULL,
+	infinite BOOLEAN NOT NULL
+)`
+
+// sqliteStore persists mover data in a local SQLite database file.
+type sqliteStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteStore opens (creating if necessary) a SQLite database at path
+// for use as a Store.
+func NewSQLiteStore(path string) (Store, error) {
+	db, err := sql.Open(`sqlite3`, path)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = db.Exec(sqliteSchema); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &sqliteStore{db: db}, nil
+}
+
+func (s *sqliteStore) Get(key string) (result []Stock, ok bool, err error) {
+	var raw string
+	var expiresAt time.Time
+	var infinite bool
+	row := s.db.QueryRow(`SELECT stocks, expires_at, infinite FROM movers_cache WHERE key = ?`, key)
+	err = row.Scan(&raw, &expiresAt, &infinite)
+	if err == sql.ErrNoRows {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if !infinite && time.Now().After(expiresAt) {
+		return nil, false, nil
+	}
+	if err = json.Unmarshal([]byte(raw), &result); err != nil {
+		return nil, false, err
+	}
+	return result, true, nil
+}
+
+func (s *sqliteStore) Put(key string, stocks []Stock, ttl time.Duration) error {
+	raw, err := json.Marshal(stocks)
+	if err != nil {
+		return err
+	}
+	infinite := ttl == 0
+	_, err = s.db.Exec(
+		`INSERT INTO movers_cache (key, stocks, expires_at, infinite) VALUES (?, ?, ?, ?)
+		 ON CONFLICT(key) DO UPDATE SET stocks = excluded.stocks, expires_at = excluded.expires_at, infinite = excluded.infinite`,
+		key, string(raw), time.Now().Add(ttl), infinite,
+	)
+	return err
+}
@@ -1,12 +1,15 @@
 package movers
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"regexp"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 	"unicode"
@@ -82,21 +85,44 @@ type Getter interface {
 	Get(list MoverList, d Date) (result []Stock, err error)
 }
 
-// NewGetter returns a Getter backed by an in-memory cache
+// Store persists fetched mover data, keyed by the upstream URL for a given
+// list and date. A ttl of 0 means the entry should be kept indefinitely,
+// which NewGetterWithStore relies on for historical dates that never change.
+type Store interface {
+	Get(key string) (result []Stock, ok bool, err error)
+	Put(key string, result []Stock, ttl time.Duration) error
+}
+
+// NewGetter returns a Getter backed by an in-memory Store.
 func NewGetter() Getter {
-	return &cache{
-		data: make(map[string]*cached),
-	}
+	return NewGetterWithStore(newMemoryStore())
 }
 
-type cached struct {
-	sync.Mutex
-	data []Stock
+// NewGetterWithStore returns a Getter backed by store. Concurrent requests
+// for the same list and date still coalesce into a single upstream fetch,
+// the same way the original in-memory-only cache behaved.
+func NewGetterWithStore(store Store) Getter {
+	return NewGetterWithOptions(store, nil)
+}
+
+// NewGetterWithOptions returns a Getter like NewGetterWithStore that also
+// records upstream fetch latency, parse errors, and cache hit/miss counts
+// against m. A nil m records nothing.
+func NewGetterWithOptions(store Store, m *Metrics) Getter {
+	return &cache{
+		store:   store,
+		locks:   make(map[string]*sync.Mutex),
+		metrics: m,
+	}
 }
 
+// cache coalesces concurrent fetches for the same key and delegates actual
+// storage to a Store, which may or may not survive process restarts.
 type cache struct {
 	sync.Mutex
-	data map[string]*cached
+	locks   map[string]*sync.Mutex
+	store   Store
+	metrics *Metrics
 }
 
 func dataURL(list MoverList, d Date) (url string, err error) {
@@ -106,26 +132,53 @@ func dataURL(list MoverList, d Date) (url string, err error) {
 	return fmt.Sprintf(string(list), d.Year, int(d.Month), d.Day), nil
 }
 
-func (c *cache) Get(list MoverList, d Date) (results []Stock, err error) {
+// ttlFor returns the TTL to use when caching d's data: infinite (0) for any
+// date before today, and a short TTL for today, whose movers can still change.
+func ttlFor(d Date) time.Duration {
+	if isToday(d) {
+		return 15 * time.Minute
+	}
+	return 0
+}
+
+func isToday(d Date) bool {
+	now := time.Now()
+	return d.Year == now.Year() && d.Month == now.Month() && d.Day == now.Day()
+}
+
+func (c *cache) lockFor(key string) *sync.Mutex {
 	c.Lock()
+	defer c.Unlock()
+	l, ok := c.locks[key]
+	if !ok {
+		l = &sync.Mutex{}
+		c.locks[key] = l
+	}
+	return l
+}
+
+func (c *cache) Get(list MoverList, d Date) (results []Stock, err error) {
 	var addr string
 	addr, err = dataURL(list, d)
 	if err != nil {
-		c.Unlock()
 		return
 	}
-	val, ok := c.data[addr]
-	if !ok {
-		val = &cached{}
-		c.data[addr] = val
-	}
-	val.Lock()
-	c.Unlock()
-	defer val.Unlock()
-	if len(val.data) > 0 {
-		results = val.data
+	lock := c.lockFor(addr)
+	lock.Lock()
+	defer lock.Unlock()
+	var ok bool
+	results, ok, err = c.store.Get(addr)
+	if err != nil {
+		return
+	}
+	c.metrics.observeCacheResult(ok)
+	if ok {
 		return
 	}
+	start := time.Now()
+	defer func() {
+		c.metrics.observeFetch(list, time.Since(start).Seconds(), err)
+	}()
 	var res *http.Response
 	res, err = netClient.Get(addr)
 	if err != nil {
@@ -136,7 +189,70 @@ func (c *cache) Get(list MoverList, d Date) (results []Stock, err error) {
 		err = fmt.Errorf("status code error: %d %s", res.StatusCode, res.Status)
 		return
 	}
-	return parseTable(res.Body)
+	results, err = parseTable(res.Body)
+	if err != nil {
+		return
+	}
+	err = c.store.Put(addr, results, ttlFor(d))
+	return
+}
+
+// wantedColumns are the header texts parseTable looks for, by name, rather
+// than assuming a fixed column position. WSJ's page presents the ticker
+// inline with the issuer name (e.g. "Apple Inc (AAPL)"), so there is no
+// separate "Symbol" header to locate.
+var wantedColumns = []string{`Name`, `Price`, `% Chg`, `Volume`}
+
+// ParseError describes a failure parsing an upstream mover table page. It
+// carries the offending row's raw HTML and a fingerprint of the header row
+// so an operator can tell at a glance whether WSJ changed its page format,
+// as opposed to a one-off malformed row.
+type ParseError struct {
+	Err         error
+	RowHTML     string
+	Fingerprint string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf(`movers: parse error (schema %s): %s`, e.Fingerprint, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// headerFingerprint hashes a table's header cell text so repeated parse
+// failures against the same page layout can be distinguished from a
+// one-off schema change.
+func headerFingerprint(headers []string) string {
+	h := sha1.New()
+	for _, c := range headers {
+		io.WriteString(h, strings.TrimSpace(c))
+		io.WriteString(h, "\x00")
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// columnIndex maps each non-empty header cell's trimmed text to its index.
+func columnIndex(headers []string) map[string]int {
+	idx := make(map[string]int, len(headers))
+	for i, h := range headers {
+		if h = strings.TrimSpace(h); h != "" {
+			idx[h] = i
+		}
+	}
+	return idx
+}
+
+func requireColumn(cols map[string]int, name string, headers []string, fingerprint string) (int, error) {
+	i, ok := cols[name]
+	if !ok {
+		return 0, &ParseError{
+			Err:         fmt.Errorf(`expected a %q column, got headers %v`, name, headers),
+			Fingerprint: fingerprint,
+		}
+	}
+	return i, nil
 }
 
 func parseTable(data io.Reader) (results []Stock, err error) {
@@ -145,9 +261,26 @@ func parseTable(data io.Reader) (results []Stock, err error) {
 	if err != nil {
 		return
 	}
-	doc.Find(`table.mdcTable tbody tr`).Slice(1, goquery.ToEnd).EachWithBreak(func(i int, sel *goquery.Selection) bool {
+	rows := doc.Find(`table.mdcTable tbody tr`)
+	if rows.Length() < 2 {
+		return nil, errors.New(`movers: no data rows found`)
+	}
+	headers := rows.First().Find(`th, td`).Map(func(_ int, sel *goquery.Selection) string {
+		return sel.Text()
+	})
+	fingerprint := headerFingerprint(headers)
+	cols := columnIndex(headers)
+	colIndex := make(map[string]int, len(wantedColumns))
+	for _, name := range wantedColumns {
+		i, colErr := requireColumn(cols, name, headers, fingerprint)
+		if colErr != nil {
+			return nil, colErr
+		}
+		colIndex[name] = i
+	}
+	rows.Slice(1, goquery.ToEnd).EachWithBreak(func(i int, sel *goquery.Selection) bool {
 		var s Stock
-		s, err = trToStock(sel)
+		s, err = trToStock(sel, colIndex, fingerprint)
 		if err != nil {
 			return false
 		}
@@ -157,39 +290,55 @@ func parseTable(data io.Reader) (results []Stock, err error) {
 	return
 }
 
-func trToStock(sel *goquery.Selection) (s Stock, err error) {
-	data := sel.Find("td").Map(func(i int, sel2 *goquery.Selection) string {
-		switch i {
-		case 1:
-			return sel2.Text()
-		default:
-			t := sel2.Text()
-			b := make([]byte, len(t))
-			n, _, _ := filterNonNumeric.Transform(b, []byte(t), true)
-			return string(b[:n])
+func trToStock(sel *goquery.Selection, cols map[string]int, fingerprint string) (s Stock, err error) {
+	cells := sel.Find(`td`)
+	cellText := func(i int) string {
+		return cells.Eq(i).Text()
+	}
+	numeric := func(i int) string {
+		t := cellText(i)
+		b := make([]byte, len(t))
+		n, _, _ := filterNonNumeric.Transform(b, []byte(t), true)
+		return string(b[:n])
+	}
+	maxCol := 0
+	for _, i := range cols {
+		if i > maxCol {
+			maxCol = i
+		}
+	}
+	if cells.Length() <= maxCol {
+		rowHTML, _ := goquery.OuterHtml(sel)
+		return s, &ParseError{
+			Err:         fmt.Errorf(`expected at least %d columns, got %d`, maxCol+1, cells.Length()),
+			RowHTML:     rowHTML,
+			Fingerprint: fingerprint,
 		}
-	})
-	if len(data) != 6 {
-		err = fmt.Errorf(`expected 6 columns, got %d`, len(data))
-		return
 	}
-	matches := nameAndSymbolPattern.FindStringSubmatch(data[1])
+	matches := nameAndSymbolPattern.FindStringSubmatch(cellText(cols[`Name`]))
 	if len(matches) != 3 {
-		err = fmt.Errorf(`expected stock name and ticker regex match to have 3 elements, got %d`, len(matches))
-		return
+		rowHTML, _ := goquery.OuterHtml(sel)
+		return s, &ParseError{
+			Err:         fmt.Errorf(`expected stock name and ticker regex match to have 3 elements, got %d`, len(matches)),
+			RowHTML:     rowHTML,
+			Fingerprint: fingerprint,
+		}
 	}
 	s.Name = matches[1]
 	s.Ticker = matches[2]
-	s.Volume, err = strconv.Atoi(data[5])
-	if err != nil {
-		return
+	if s.Volume, err = strconv.Atoi(numeric(cols[`Volume`])); err != nil {
+		rowHTML, _ := goquery.OuterHtml(sel)
+		return s, &ParseError{Err: err, RowHTML: rowHTML, Fingerprint: fingerprint}
 	}
-	s.Price, err = strconv.ParseFloat(data[2], 64)
-	if err != nil {
-		return
+	if s.Price, err = strconv.ParseFloat(numeric(cols[`Price`]), 64); err != nil {
+		rowHTML, _ := goquery.OuterHtml(sel)
+		return s, &ParseError{Err: err, RowHTML: rowHTML, Fingerprint: fingerprint}
 	}
-	s.PctChange, err = strconv.ParseFloat(data[4], 64)
-	return
+	if s.PctChange, err = strconv.ParseFloat(numeric(cols[`% Chg`]), 64); err != nil {
+		rowHTML, _ := goquery.OuterHtml(sel)
+		return s, &ParseError{Err: err, RowHTML: rowHTML, Fingerprint: fingerprint}
+	}
+	return s, nil
 }
 
 var filterNonNumeric = transform.RemoveFunc(func(r rune) bool {
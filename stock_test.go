@@ -0,0 +1,88 @@
+package movers
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func tableHTML(headers []string, row []string) string {
+	var b strings.Builder
+	b.WriteString(`<table class="mdcTable"><tbody><tr>`)
+	for _, h := range headers {
+		b.WriteString(`<th>` + h + `</th>`)
+	}
+	b.WriteString(`</tr><tr>`)
+	for _, c := range row {
+		b.WriteString(`<td>` + c + `</td>`)
+	}
+	b.WriteString(`</tr></tbody></table>`)
+	return b.String()
+}
+
+func TestParseTable(t *testing.T) {
+	headers := []string{``, `Name`, `Price`, `Chg`, `% Chg`, `Volume`}
+	row := []string{`1`, `Apple Inc (AAPL)`, `123.45`, `+1.23`, `1.01%`, `1,234,567`}
+	results, err := parseTable(strings.NewReader(tableHTML(headers, row)))
+	if err != nil {
+		t.Fatalf(`unexpected error: %s`, err)
+	}
+	if len(results) != 1 {
+		t.Fatalf(`expected 1 stock, got %d`, len(results))
+	}
+	got := results[0]
+	want := Stock{Ticker: `AAPL`, Name: `Apple Inc`, Price: 123.45, PctChange: 1.01, Volume: 1234567}
+	if got != want {
+		t.Fatalf(`got %+v, want %+v`, got, want)
+	}
+}
+
+func TestParseTable_ColumnsLocatedByHeaderTextNotPosition(t *testing.T) {
+	// Same data, but the upstream page has reordered its columns. A
+	// position-based parser would misread this; a header-based one
+	// shouldn't care.
+	headers := []string{`Volume`, `% Chg`, `Name`, `Price`}
+	row := []string{`1,234,567`, `1.01%`, `Apple Inc (AAPL)`, `123.45`}
+	results, err := parseTable(strings.NewReader(tableHTML(headers, row)))
+	if err != nil {
+		t.Fatalf(`unexpected error: %s`, err)
+	}
+	want := Stock{Ticker: `AAPL`, Name: `Apple Inc`, Price: 123.45, PctChange: 1.01, Volume: 1234567}
+	if len(results) != 1 || results[0] != want {
+		t.Fatalf(`got %+v, want [%+v]`, results, want)
+	}
+}
+
+func TestParseTable_MissingHeaderIsParseError(t *testing.T) {
+	// No "% Chg" column at all - a schema change, not a bad row.
+	headers := []string{``, `Name`, `Price`, `Chg`, `Volume`}
+	row := []string{`1`, `Apple Inc (AAPL)`, `123.45`, `+1.23`, `1,234,567`}
+	_, err := parseTable(strings.NewReader(tableHTML(headers, row)))
+	var perr *ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf(`expected a *ParseError, got %v (%T)`, err, err)
+	}
+	if perr.Fingerprint == `` {
+		t.Fatalf(`expected a non-empty schema fingerprint`)
+	}
+}
+
+func TestParseTable_ShortRowIsParseError(t *testing.T) {
+	headers := []string{``, `Name`, `Price`, `Chg`, `% Chg`, `Volume`}
+	row := []string{`1`, `Apple Inc (AAPL)`, `123.45`}
+	_, err := parseTable(strings.NewReader(tableHTML(headers, row)))
+	var perr *ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf(`expected a *ParseError, got %v (%T)`, err, err)
+	}
+	if perr.RowHTML == `` {
+		t.Fatalf(`expected ParseError to carry the offending row's HTML`)
+	}
+}
+
+func TestParseTable_NoDataRows(t *testing.T) {
+	_, err := parseTable(strings.NewReader(`<table class="mdcTable"><tbody></tbody></table>`))
+	if err == nil {
+		t.Fatal(`expected an error for a table with no rows`)
+	}
+}
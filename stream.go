@@ -0,0 +1,213 @@
+package movers
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	streamClientBuffer = 32
+	pingInterval       = 30 * time.Second
+	pongWait           = 60 * time.Second
+	reconnectBaseDelay = time.Second
+	reconnectMaxDelay  = 30 * time.Second
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin:     func(r *http.Request) bool { return true },
+}
+
+// StreamUpdate is a single push update for a MoverList, emitted by a
+// StreamSource as a trade or quote moves a symbol's daily percent change.
+type StreamUpdate struct {
+	List  MoverList
+	Stock Stock
+}
+
+// StreamSource is implemented by data sources capable of pushing live mover
+// updates, as an alternative to polling Get or GetLive. Stream returns a
+// channel of updates that is closed when the upstream connection ends; the
+// hub reconnects with backoff by calling Stream again.
+type StreamSource interface {
+	Stream(ctx context.Context) (<-chan StreamUpdate, error)
+}
+
+// client is one subscribed WebSocket connection.
+type client struct {
+	conn  *websocket.Conn
+	send  chan StreamUpdate
+	mu    sync.Mutex
+	lists map[MoverList]bool
+}
+
+func (c *client) subscribe(list MoverList) {
+	c.mu.Lock()
+	c.lists[list] = true
+	c.mu.Unlock()
+}
+
+func (c *client) subscribed(list MoverList) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lists[list]
+}
+
+// deliver enqueues u on the client's bounded channel. If the client is
+// falling behind, the oldest queued update is dropped to make room rather
+// than blocking the hub's broadcast loop.
+func (c *client) deliver(u StreamUpdate) {
+	select {
+	case c.send <- u:
+		return
+	default:
+	}
+	select {
+	case <-c.send:
+	default:
+	}
+	select {
+	case c.send <- u:
+	default:
+	}
+}
+
+// hub fans out StreamUpdates from an upstream StreamSource to subscribed
+// WebSocket clients, each with its own bounded, drop-oldest outbound queue.
+type hub struct {
+	mu      sync.Mutex
+	clients map[*client]bool
+}
+
+func newHub() *hub {
+	return &hub{clients: make(map[*client]bool)}
+}
+
+func (h *hub) add(c *client) {
+	h.mu.Lock()
+	h.clients[c] = true
+	h.mu.Unlock()
+}
+
+func (h *hub) remove(c *client) {
+	h.mu.Lock()
+	_, ok := h.clients[c]
+	delete(h.clients, c)
+	h.mu.Unlock()
+	if ok {
+		close(c.send)
+	}
+}
+
+func (h *hub) broadcast(u StreamUpdate) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for c := range h.clients {
+		if c.subscribed(u.List) {
+			c.deliver(u)
+		}
+	}
+}
+
+// run consumes updates from src until ctx is canceled, reconnecting with
+// exponential backoff whenever the upstream stream ends or errors.
+func (h *hub) run(ctx context.Context, src StreamSource) {
+	delay := reconnectBaseDelay
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+		updates, err := src.Stream(ctx)
+		if err != nil {
+			log.Printf(`movers: stream source error: %s`, err)
+		} else {
+			received := false
+			for u := range updates {
+				received = true
+				h.broadcast(u)
+			}
+			if received {
+				delay = reconnectBaseDelay
+			}
+		}
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+		delay *= 2
+		if delay > reconnectMaxDelay {
+			delay = reconnectMaxDelay
+		}
+	}
+}
+
+func (c *client) writePump() {
+	ticker := time.NewTicker(pingInterval)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+	for {
+		select {
+		case u, ok := <-c.send:
+			if !ok {
+				c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+				return
+			}
+			if err := c.conn.WriteJSON(u.Stock); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *client) readPump(h *hub) {
+	defer h.remove(c)
+	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		c.conn.SetReadDeadline(time.Now().Add(pongWait))
+		return nil
+	})
+	for {
+		if _, _, err := c.conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+// serveStream upgrades r to a WebSocket, subscribes the connection to list,
+// and streams matching updates as JSON Stock messages until the client
+// disconnects.
+func (s *server) serveStream(list MoverList) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.hub == nil {
+			http.Error(w, `streaming is not configured for this server`, http.StatusNotImplemented)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		c := &client{
+			conn:  conn,
+			send:  make(chan StreamUpdate, streamClientBuffer),
+			lists: map[MoverList]bool{list: true},
+		}
+		s.hub.add(c)
+		go c.writePump()
+		c.readPump(s.hub)
+	}
+}
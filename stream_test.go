@@ -0,0 +1,76 @@
+package movers
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+)
+
+// fakeStreamSource is a StreamSource whose updates are driven by the test
+// rather than by polling an upstream API.
+type fakeStreamSource struct {
+	updates chan StreamUpdate
+}
+
+func (f *fakeStreamSource) Stream(ctx context.Context) (<-chan StreamUpdate, error) {
+	return f.updates, nil
+}
+
+// TestServeStream_UpgradesThroughNewHandlerWithOptions dials a real
+// gorilla/websocket client against a handler built the way production does
+// (NewHandlerWithOptions, which wraps the router in withAccessLog). Before
+// statusRecorder grew Hijack/Flush passthroughs, the upgrade failed here
+// with "websocket: response does not implement http.Hijacker" because
+// embedding http.ResponseWriter by interface doesn't promote Hijack.
+func TestServeStream_UpgradesThroughNewHandlerWithOptions(t *testing.T) {
+	src := &fakeStreamSource{updates: make(chan StreamUpdate, 1)}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	router := mux.NewRouter()
+	h := NewHandlerWithOptions(ctx, router, Options{Stream: src})
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	wsURL := `ws` + strings.TrimPrefix(srv.URL, `http`) + `/stream/gainers`
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Fatalf(`websocket dial through NewHandlerWithOptions failed: %s`, err)
+	}
+	defer conn.Close()
+
+	src.updates <- StreamUpdate{List: USCompositeGainers, Stock: Stock{Ticker: `AAPL`}}
+
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	var got Stock
+	if err := conn.ReadJSON(&got); err != nil {
+		t.Fatalf(`ReadJSON failed: %s`, err)
+	}
+	if got.Ticker != `AAPL` {
+		t.Fatalf(`got %+v, want ticker AAPL`, got)
+	}
+}
+
+// TestServeStream_NotConfiguredReturns501 confirms the handler still answers
+// sanely when no Stream source was supplied, rather than panicking on a nil
+// hub.
+func TestServeStream_NotConfiguredReturns501(t *testing.T) {
+	router := mux.NewRouter()
+	h := NewHandlerWithOptions(context.Background(), router, Options{})
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	wsURL := `ws` + strings.TrimPrefix(srv.URL, `http`) + `/stream/gainers`
+	_, resp, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err == nil {
+		t.Fatal(`expected the dial to fail when streaming is not configured`)
+	}
+	if resp == nil || resp.StatusCode != 501 {
+		t.Fatalf(`expected a 501 response, got %+v`, resp)
+	}
+}